@@ -0,0 +1,119 @@
+// Copyright 2023 Adevinta
+
+package config
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// checktypesSourceSchemes are the URL schemes accepted by a
+// [ChecktypesSource]. "git+https" is checked as a prefix, as the Git
+// reference and subpath are appended to it.
+var checktypesSourceSchemes = []string{
+	"https://",
+	"file://",
+	"oci://",
+	"git+https://",
+}
+
+// ChecktypesSource represents a source of a checktypes catalog.
+//
+// This type only covers the config-file representation of a source:
+// parsing its URL scheme and the shape of [ChecktypesSource.Checksum].
+// Resolving a source (pulling an "oci" artifact, cloning a
+// "git+https" repository, verifying the checksum or the cosign
+// signature) is done by the catalog resolver that consumes this
+// config, not by this package.
+type ChecktypesSource struct {
+	// URL is the location of the catalog. Supported schemes are
+	// "https", "file", "oci" and "git+https". For instance, an
+	// "oci" source is meant to be pulled from a container registry
+	// using the credentials in [AgentConfig.RegistriesAuth], and a
+	// "git+https" source is meant to be read from a given ref,
+	// e.g. "git+https://host/repo//catalog.json@main".
+	URL string `yaml:"url"`
+
+	// Checksum is the expected checksum of the resolved catalog,
+	// e.g. "sha256:<64 hex chars>". Only the "sha256" algorithm is
+	// currently accepted.
+	Checksum string `yaml:"checksum"`
+
+	// CosignPublicKey is the cosign public key used to verify the
+	// signature of an "oci" catalog.
+	CosignPublicKey string `yaml:"cosignPublicKey"`
+}
+
+// UnmarshalYAML decodes a ChecktypesSource yaml node. The node can
+// either be a plain string containing the URL of the source, or a
+// mapping with the fields of [ChecktypesSource].
+//
+// The mapping form is decoded field-by-field instead of via
+// [yaml.Node.Decode], because a [yaml.Node] does not inherit the
+// [yaml.Decoder.KnownFields] setting of the decoder that produced
+// it, which would otherwise let typos like "checksumm" parse
+// silently.
+func (s *ChecktypesSource) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		s.URL = value.Value
+		return nil
+	}
+
+	if value.Kind != yaml.MappingNode {
+		return fmt.Errorf("checktypes source must be a string or a mapping")
+	}
+
+	for i := 0; i < len(value.Content); i += 2 {
+		key, val := value.Content[i], value.Content[i+1]
+		switch key.Value {
+		case "url":
+			s.URL = val.Value
+		case "checksum":
+			s.Checksum = val.Value
+		case "cosignPublicKey":
+			s.CosignPublicKey = val.Value
+		default:
+			return fmt.Errorf("field %v not found in type config.ChecktypesSource", key.Value)
+		}
+	}
+	return nil
+}
+
+// validate validates the checktypes source.
+func (s ChecktypesSource) validate() error {
+	var hasScheme bool
+	for _, scheme := range checktypesSourceSchemes {
+		if strings.HasPrefix(s.URL, scheme) {
+			hasScheme = true
+			break
+		}
+	}
+	if !hasScheme {
+		return fmt.Errorf("%w: %v", ErrInvalidChecktypesSource, s.URL)
+	}
+	if s.Checksum != "" {
+		if err := validateChecksum(s.Checksum); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidChecktypesSource, err)
+		}
+	}
+	return nil
+}
+
+// validateChecksum validates that checksum has the form
+// "sha256:<64 hex chars>".
+func validateChecksum(checksum string) error {
+	const algo = "sha256:"
+
+	if !strings.HasPrefix(checksum, algo) {
+		return fmt.Errorf("unsupported checksum algorithm: %v", checksum)
+	}
+
+	digest := strings.TrimPrefix(checksum, algo)
+	if raw, err := hex.DecodeString(digest); err != nil || len(raw) != 32 {
+		return fmt.Errorf("invalid sha256 checksum: %v", checksum)
+	}
+	return nil
+}
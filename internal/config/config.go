@@ -39,6 +39,29 @@ var (
 	// ErrInvalidOutputFormat means that the output format is
 	// invalid.
 	ErrInvalidOutputFormat = errors.New("invalid output format")
+
+	// ErrInvalidStatus means that the status is invalid.
+	ErrInvalidStatus = errors.New("invalid status")
+
+	// ErrInvalidExclusionAction means that the exclusion action is
+	// invalid.
+	ErrInvalidExclusionAction = errors.New("invalid exclusion action")
+
+	// ErrInvalidExclusionScope means that the exclusion scope is
+	// invalid.
+	ErrInvalidExclusionScope = errors.New("invalid exclusion scope")
+
+	// ErrInvalidTargetOption means that a target option is invalid
+	// for the asset type of the target.
+	ErrInvalidTargetOption = errors.New("invalid target option")
+
+	// ErrInvalidContainerOption means that a container option is
+	// invalid.
+	ErrInvalidContainerOption = errors.New("invalid container option")
+
+	// ErrInvalidChecktypesSource means that a checktypes source is
+	// invalid.
+	ErrInvalidChecktypesSource = errors.New("invalid checktypes source")
 )
 
 // Config represents a Lava configuration.
@@ -52,9 +75,8 @@ type Config struct {
 	// ReportConfig is the configuration of the report.
 	ReportConfig ReportConfig `yaml:"report"`
 
-	// ChecktypesURLs is a list of URLs pointing to checktypes
-	// catalogs.
-	ChecktypesURLs []string `yaml:"checktypesURLs"`
+	// Checktypes is a list of sources of checktypes catalogs.
+	Checktypes []ChecktypesSource `yaml:"checktypes"`
 
 	// Targets is the list of targets.
 	Targets []Target `yaml:"targets"`
@@ -66,7 +88,9 @@ type Config struct {
 // Parse returns a parsed Lava configuration given an [io.Reader].
 func Parse(r io.Reader) (Config, error) {
 	var cfg Config
-	if err := yaml.NewDecoder(r).Decode(&cfg); err != nil {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil {
 		return Config{}, fmt.Errorf("decode config: %w", err)
 	}
 	if err := cfg.validate(); err != nil {
@@ -101,7 +125,23 @@ func (c *Config) validate() error {
 		if target.Identifier == "" {
 			return ErrNoTargetIdentifier
 		}
+		if err := validateTargetOptions(target.AssetType, target.Options); err != nil {
+			return err
+		}
+	}
+
+	// Container options validation.
+	if err := validateContainerOptions(c.AgentConfig.ContainerOptions); err != nil {
+		return err
+	}
+
+	// Checktypes sources validation.
+	for _, src := range c.Checktypes {
+		if err := src.validate(); err != nil {
+			return err
+		}
 	}
+
 	return nil
 }
 
@@ -121,6 +161,15 @@ type AgentConfig struct {
 	// RegistriesAuth contains the credentials for a set of
 	// container registries.
 	RegistriesAuth []RegistryAuth `yaml:"registriesAuth"`
+
+	// ContainerOptions is a list of docker-CLI-compatible flags
+	// merged into the check container configuration before it is
+	// submitted to vulcan-agent, e.g. "--cap-add=NET_ADMIN" or
+	// "-v=/data:/data:ro". Entries are validated against a
+	// supported-flag allowlist at config-load time, and
+	// [AgentConfig.ContainerOverrides] groups them by flag ready to
+	// be merged into the check container's HostConfig/Config.
+	ContainerOptions []string `yaml:"containerOptions"`
 }
 
 // ReportConfig is the configuration of the report.
@@ -138,6 +187,13 @@ type ReportConfig struct {
 	// Exclusions is a list of findings that will be ignored. For
 	// instance, accepted risks, false positives, etc.
 	Exclusions []Exclusion `yaml:"exclusions"`
+
+	// Status is the list of vulnerability statuses that are
+	// allowed in the report. Findings whose status is not in this
+	// list are dropped by
+	// [github.com/adevinta/lava/internal/report.Apply]. If empty,
+	// it defaults to [StatusAffected].
+	Status []Status `yaml:"status"`
 }
 
 // Target represents the target of a scan.
@@ -207,16 +263,71 @@ func (s *Severity) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// Status is the lifecycle status of a vulnerability.
+type Status int
+
+// Vulnerability statuses.
+const (
+	StatusUnknown Status = iota
+	StatusNotAffected
+	StatusAffected
+	StatusFixed
+	StatusUnderInvestigation
+	StatusWillNotFix
+	StatusFixDeferred
+	StatusEndOfLife
+)
+
+var statusNames = map[string]Status{
+	"unknown":             StatusUnknown,
+	"not_affected":        StatusNotAffected,
+	"affected":            StatusAffected,
+	"fixed":               StatusFixed,
+	"under_investigation": StatusUnderInvestigation,
+	"will_not_fix":        StatusWillNotFix,
+	"fix_deferred":        StatusFixDeferred,
+	"end_of_life":         StatusEndOfLife,
+}
+
+// parseStatus converts a string into a [Status] value.
+func parseStatus(status string) (Status, error) {
+	if val, ok := statusNames[status]; ok {
+		return val, nil
+	}
+
+	var zero Status
+	return zero, fmt.Errorf("%w: %v", ErrInvalidStatus, status)
+}
+
+// UnmarshalYAML decodes a Status yaml node containing a string into
+// a [Status] value. It returns error if the provided string does
+// not match any known status.
+func (s *Status) UnmarshalYAML(value *yaml.Node) error {
+	status, err := parseStatus(value.Value)
+	if err != nil {
+		return err
+	}
+	*s = status
+	return nil
+}
+
 // OutputFormat is the format of the generated report.
 type OutputFormat int
 
 // Output formats available for the report.
 const (
 	OutputFormatJSON OutputFormat = 0
+
+	// OutputFormatSARIF selects the SARIF 2.1.0 format, rendered by
+	// [github.com/adevinta/lava/internal/report.RenderSARIF], so
+	// the report can be ingested by tools like GitHub code
+	// scanning or GitLab.
+	OutputFormatSARIF OutputFormat = 1
 )
 
 var outputFormatNames = map[string]OutputFormat{
-	"json": OutputFormatJSON,
+	"json":  OutputFormatJSON,
+	"sarif": OutputFormatSARIF,
 }
 
 // parseOutputFormat converts a string into an [OutputFormat] value.
@@ -259,6 +370,115 @@ type Exclusion struct {
 
 	// Description describes the exclusion.
 	Description string `yaml:"description"`
+
+	// Action is the action taken on a matching finding by
+	// [github.com/adevinta/lava/internal/report.Apply], defaulting
+	// to [ExclusionActionDeny] when empty.
+	Action ExclusionAction `yaml:"action"`
+
+	// Scope is the part of the run affected by [Exclusion.Action]:
+	// report visibility, the exit code, or both. It defaults to
+	// [ExclusionScopeAll] when empty.
+	Scope ExclusionScope `yaml:"scope"`
+}
+
+// ExclusionAction represents the action taken on a finding that
+// matches an [Exclusion].
+type ExclusionAction int
+
+// Exclusion actions.
+const (
+	// ExclusionActionDeny excludes the finding from the report and
+	// from the exit code calculation, i.e. the behavior of an
+	// exclusion without an action.
+	ExclusionActionDeny ExclusionAction = iota
+
+	// ExclusionActionWarn keeps the finding visible in the report
+	// but does not make it count towards the exit code.
+	ExclusionActionWarn
+
+	// ExclusionActionDryRun records the match in the DryRun field
+	// of the report result without affecting the visible findings
+	// or the exit code. The SARIF format renders these matches as
+	// suppressed results; the JSON format does not include them.
+	ExclusionActionDryRun
+)
+
+var exclusionActionNames = map[string]ExclusionAction{
+	"deny":   ExclusionActionDeny,
+	"warn":   ExclusionActionWarn,
+	"dryrun": ExclusionActionDryRun,
+}
+
+// parseExclusionAction converts a string into an [ExclusionAction]
+// value.
+func parseExclusionAction(action string) (ExclusionAction, error) {
+	if val, ok := exclusionActionNames[action]; ok {
+		return val, nil
+	}
+
+	var zero ExclusionAction
+	return zero, fmt.Errorf("%w: %v", ErrInvalidExclusionAction, action)
+}
+
+// UnmarshalYAML decodes an ExclusionAction yaml node containing a
+// string into an [ExclusionAction] value. It returns error if the
+// provided string does not match any known exclusion action.
+func (a *ExclusionAction) UnmarshalYAML(value *yaml.Node) error {
+	action, err := parseExclusionAction(value.Value)
+	if err != nil {
+		return err
+	}
+	*a = action
+	return nil
+}
+
+// ExclusionScope represents the part of a run affected by an
+// [Exclusion.Action].
+type ExclusionScope int
+
+// Exclusion scopes.
+const (
+	// ExclusionScopeAll applies the exclusion action to both the
+	// report and the exit code.
+	ExclusionScopeAll ExclusionScope = iota
+
+	// ExclusionScopeReport applies the exclusion action only to
+	// the rendered report.
+	ExclusionScopeReport
+
+	// ExclusionScopeExit applies the exclusion action only to the
+	// exit code calculation.
+	ExclusionScopeExit
+)
+
+var exclusionScopeNames = map[string]ExclusionScope{
+	"all":    ExclusionScopeAll,
+	"report": ExclusionScopeReport,
+	"exit":   ExclusionScopeExit,
+}
+
+// parseExclusionScope converts a string into an [ExclusionScope]
+// value.
+func parseExclusionScope(scope string) (ExclusionScope, error) {
+	if val, ok := exclusionScopeNames[scope]; ok {
+		return val, nil
+	}
+
+	var zero ExclusionScope
+	return zero, fmt.Errorf("%w: %v", ErrInvalidExclusionScope, scope)
+}
+
+// UnmarshalYAML decodes an ExclusionScope yaml node containing a
+// string into an [ExclusionScope] value. It returns error if the
+// provided string does not match any known exclusion scope.
+func (s *ExclusionScope) UnmarshalYAML(value *yaml.Node) error {
+	scope, err := parseExclusionScope(value.Value)
+	if err != nil {
+		return err
+	}
+	*s = scope
+	return nil
 }
 
 // AssetType represents the type of an asset.
@@ -274,4 +494,4 @@ func (t *AssetType) UnmarshalYAML(value *yaml.Node) error {
 	}
 	*t = AssetType(at)
 	return nil
-}
\ No newline at end of file
+}
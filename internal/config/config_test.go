@@ -133,6 +133,186 @@ func TestParse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "SARIF output format",
+			file: "testdata/sarif_output_format.yaml",
+			want: Config{
+				LavaVersion: "v1.0.0",
+				Targets: []Target{
+					{
+						Identifier: "example.com",
+					},
+				},
+				ReportConfig: ReportConfig{
+					Format: OutputFormatSARIF,
+				},
+			},
+		},
+		{
+			name: "status",
+			file: "testdata/status.yaml",
+			want: Config{
+				LavaVersion: "v1.0.0",
+				ReportConfig: ReportConfig{
+					Status: []Status{StatusAffected, StatusFixed},
+				},
+				Targets: []Target{
+					{
+						Identifier: "example.com",
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid status",
+			file:    "testdata/invalid_status.yaml",
+			want:    Config{},
+			wantErr: ErrInvalidStatus,
+		},
+		{
+			name: "exclusion action and scope",
+			file: "testdata/exclusion_action_scope.yaml",
+			want: Config{
+				LavaVersion: "v1.0.0",
+				ReportConfig: ReportConfig{
+					Exclusions: []Exclusion{
+						{
+							Target:   "example.com",
+							Resource: "CVE-2023-1234",
+							Summary:  "accepted risk",
+							Action:   ExclusionActionWarn,
+							Scope:    ExclusionScopeExit,
+						},
+					},
+				},
+				Targets: []Target{
+					{
+						Identifier: "example.com",
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid exclusion action",
+			file:    "testdata/invalid_exclusion_action.yaml",
+			want:    Config{},
+			wantErr: ErrInvalidExclusionAction,
+		},
+		{
+			name:    "invalid exclusion scope",
+			file:    "testdata/invalid_exclusion_scope.yaml",
+			want:    Config{},
+			wantErr: ErrInvalidExclusionScope,
+		},
+		{
+			name: "target options",
+			file: "testdata/target_options.yaml",
+			want: Config{
+				LavaVersion: "v1.0.0",
+				Targets: []Target{
+					{
+						Identifier: "example.com",
+						AssetType:  "WebAddress",
+						Options: map[string]any{
+							"timeout":          30,
+							"follow_redirects": true,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid target option",
+			file:    "testdata/invalid_target_option.yaml",
+			want:    Config{},
+			wantErr: ErrInvalidTargetOption,
+		},
+		{
+			name: "container options",
+			file: "testdata/container_options.yaml",
+			want: Config{
+				LavaVersion: "v1.0.0",
+				AgentConfig: AgentConfig{
+					ContainerOptions: []string{"--cap-add=NET_ADMIN", "-v=/data:/data:ro"},
+				},
+				Targets: []Target{
+					{
+						Identifier: "example.com",
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid container option",
+			file:    "testdata/invalid_container_option.yaml",
+			want:    Config{},
+			wantErr: ErrInvalidContainerOption,
+		},
+		{
+			name:    "invalid container option value",
+			file:    "testdata/invalid_container_option_value.yaml",
+			want:    Config{},
+			wantErr: ErrInvalidContainerOption,
+		},
+		{
+			name: "bare container option value",
+			file: "testdata/bare_container_option_value.yaml",
+			want: Config{
+				LavaVersion: "v1.0.0",
+				AgentConfig: AgentConfig{
+					ContainerOptions: []string{"-e MYVAR", "--security-opt no-new-privileges"},
+				},
+				Targets: []Target{
+					{
+						Identifier: "example.com",
+					},
+				},
+			},
+		},
+		{
+			name: "checktypes sources",
+			file: "testdata/checktypes_sources.yaml",
+			want: Config{
+				LavaVersion: "v1.0.0",
+				Checktypes: []ChecktypesSource{
+					{URL: "https://example.com/checktypes.json"},
+					{
+						URL:             "oci://registry.example.com/checktypes:v1",
+						Checksum:        "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+						CosignPublicKey: "cosign.pub",
+					},
+				},
+				Targets: []Target{
+					{
+						Identifier: "example.com",
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid checktypes source",
+			file:    "testdata/invalid_checktypes_source.yaml",
+			want:    Config{},
+			wantErr: ErrInvalidChecktypesSource,
+		},
+		{
+			name:          "invalid checktypes source field",
+			file:          "testdata/invalid_checktypes_source_field.yaml",
+			want:          Config{},
+			wantErrRegexp: regexp.MustCompile(`field checksumm not found`),
+		},
+		{
+			name:    "invalid checktypes checksum",
+			file:    "testdata/invalid_checktypes_checksum.yaml",
+			want:    Config{},
+			wantErr: ErrInvalidChecktypesSource,
+		},
+		{
+			name:          "unknown field",
+			file:          "testdata/unknown_field.yaml",
+			want:          Config{},
+			wantErrRegexp: regexp.MustCompile(`field checktypesURLs not found`),
+		},
 		{
 			name:    "invalid output format",
 			file:    "testdata/invalid_output_format.yaml",
@@ -186,4 +366,30 @@ func TestParse(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestAgentConfig_ContainerOverrides(t *testing.T) {
+	ac := AgentConfig{
+		ContainerOptions: []string{
+			"--cap-add=NET_ADMIN",
+			"-v=/data:/data:ro",
+			"-e MYVAR",
+			"--sysctl=net.core.somaxconn=1024",
+		},
+	}
+
+	got, err := ac.ContainerOverrides()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := ContainerOverrides{
+		CapAdd:  []string{"NET_ADMIN"},
+		Volumes: []string{"/data:/data:ro"},
+		Env:     []string{"MYVAR"},
+		Sysctl:  map[string]string{"net.core.somaxconn": "1024"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("container overrides mismatch (-want +got):\n%v", diff)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2023 Adevinta
+
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// containerOptionValidator validates the value of a docker-CLI
+// container option flag.
+type containerOptionValidator func(value string) error
+
+// supportedContainerOptionFlags maps the docker-CLI run flags
+// accepted in [AgentConfig.ContainerOptions] to the validator used to
+// check the shape of their value.
+var supportedContainerOptionFlags = map[string]containerOptionValidator{
+	"--cap-add":      validateNonEmptyOptionValue,
+	"--security-opt": validateKeyValueOptionValue,
+	"--tmpfs":        validateNonEmptyOptionValue,
+	"--ulimit":       validateKeyValueOptionValue,
+	"--sysctl":       validateKeyValueOptionValue,
+	"-v":             validateMountOptionValue,
+	"--volume":       validateMountOptionValue,
+	"-e":             validateKeyValueOptionValue,
+	"--env":          validateKeyValueOptionValue,
+	"--network":      validateNonEmptyOptionValue,
+	"--dns":          validateNonEmptyOptionValue,
+	"--add-host":     validateMountOptionValue,
+}
+
+// validateContainerOptions validates that every entry in opts uses a
+// supported docker-CLI flag with a well-formed value. Entries are
+// expected in the docker-CLI form "--flag=value" or "--flag value".
+func validateContainerOptions(opts []string) error {
+	for _, opt := range opts {
+		flag, value, err := splitContainerOption(opt)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidContainerOption, err)
+		}
+
+		validate, ok := supportedContainerOptionFlags[flag]
+		if !ok {
+			return fmt.Errorf("%w: unsupported flag: %v", ErrInvalidContainerOption, opt)
+		}
+		if err := validate(value); err != nil {
+			return fmt.Errorf("%w: %v: %w", ErrInvalidContainerOption, opt, err)
+		}
+	}
+	return nil
+}
+
+// splitContainerOption splits a docker-CLI-compatible container
+// option into its flag and value.
+func splitContainerOption(opt string) (flag, value string, err error) {
+	i := strings.IndexAny(opt, "= ")
+	if i < 0 {
+		return "", "", fmt.Errorf("missing value: %v", opt)
+	}
+	return opt[:i], strings.TrimSpace(opt[i+1:]), nil
+}
+
+// validateNonEmptyOptionValue validates that value is not empty.
+func validateNonEmptyOptionValue(value string) error {
+	if value == "" {
+		return fmt.Errorf("missing value")
+	}
+	return nil
+}
+
+// validateKeyValueOptionValue validates the value of flags like -e,
+// --sysctl and --security-opt, which accept either a bare name (e.g.
+// "-e MYVAR" forwards the host's MYVAR, "--security-opt
+// no-new-privileges") or a "key=value" pair (e.g. "-e MYVAR=foo").
+// Only the "key=value" form is rejected when the key is empty, since
+// that can never be a valid bare name either.
+func validateKeyValueOptionValue(value string) error {
+	if value == "" {
+		return fmt.Errorf("missing value")
+	}
+	if key, _, found := strings.Cut(value, "="); found && key == "" {
+		return fmt.Errorf("value must not start with '=': %v", value)
+	}
+	return nil
+}
+
+// validateMountOptionValue validates that value has the docker
+// "src:dst[:opts]" form used by flags like -v and --add-host.
+func validateMountOptionValue(value string) error {
+	src, rest, ok := strings.Cut(value, ":")
+	if !ok || src == "" || rest == "" {
+		return fmt.Errorf("value must have the form src:dst: %v", value)
+	}
+	return nil
+}
+
+// ContainerOverrides groups [AgentConfig.ContainerOptions] by docker
+// flag, ready to be merged into the check container's
+// HostConfig/Config before it is submitted to vulcan-agent.
+type ContainerOverrides struct {
+	CapAdd      []string
+	SecurityOpt []string
+	Tmpfs       []string
+	Ulimit      []string
+	Sysctl      map[string]string
+	Volumes     []string
+	Env         []string
+	Network     string
+	DNS         []string
+	ExtraHosts  []string
+}
+
+// ContainerOverrides parses and validates c.ContainerOptions and
+// groups the resulting values by docker flag.
+func (c AgentConfig) ContainerOverrides() (ContainerOverrides, error) {
+	var overrides ContainerOverrides
+	for _, opt := range c.ContainerOptions {
+		flag, value, err := splitContainerOption(opt)
+		if err != nil {
+			return ContainerOverrides{}, fmt.Errorf("%w: %w", ErrInvalidContainerOption, err)
+		}
+
+		validate, ok := supportedContainerOptionFlags[flag]
+		if !ok {
+			return ContainerOverrides{}, fmt.Errorf("%w: unsupported flag: %v", ErrInvalidContainerOption, opt)
+		}
+		if err := validate(value); err != nil {
+			return ContainerOverrides{}, fmt.Errorf("%w: %v: %w", ErrInvalidContainerOption, opt, err)
+		}
+
+		switch flag {
+		case "--cap-add":
+			overrides.CapAdd = append(overrides.CapAdd, value)
+		case "--security-opt":
+			overrides.SecurityOpt = append(overrides.SecurityOpt, value)
+		case "--tmpfs":
+			overrides.Tmpfs = append(overrides.Tmpfs, value)
+		case "--ulimit":
+			overrides.Ulimit = append(overrides.Ulimit, value)
+		case "--sysctl":
+			if overrides.Sysctl == nil {
+				overrides.Sysctl = make(map[string]string)
+			}
+			key, val, _ := strings.Cut(value, "=")
+			overrides.Sysctl[key] = val
+		case "-v", "--volume":
+			overrides.Volumes = append(overrides.Volumes, value)
+		case "-e", "--env":
+			overrides.Env = append(overrides.Env, value)
+		case "--network":
+			overrides.Network = value
+		case "--dns":
+			overrides.DNS = append(overrides.DNS, value)
+		case "--add-host":
+			overrides.ExtraHosts = append(overrides.ExtraHosts, value)
+		}
+	}
+	return overrides, nil
+}
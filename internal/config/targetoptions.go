@@ -0,0 +1,81 @@
+// Copyright 2023 Adevinta
+
+package config
+
+import "fmt"
+
+// targetOptionValidator validates the value of a target option.
+type targetOptionValidator func(v any) error
+
+// commonHTTPOptions are the target options accepted by asset types
+// that are reachable over HTTP.
+var commonHTTPOptions = map[string]targetOptionValidator{
+	"timeout":          validateIntOption,
+	"depth":            validateIntOption,
+	"follow_redirects": validateBoolOption,
+	"headers":          validateHeadersOption,
+}
+
+// targetOptionRegistry defines, per [AssetType], the common target
+// options with a known meaning and the validator used to check that
+// their value has the expected type. Options that are not present in
+// the registry for a given asset type are passed through to the
+// checktype without validation.
+var targetOptionRegistry = map[AssetType]map[string]targetOptionValidator{
+	AssetType("Hostname"):      commonHTTPOptions,
+	AssetType("DomainName"):    commonHTTPOptions,
+	AssetType("WebAddress"):    commonHTTPOptions,
+	AssetType("IP"):            commonHTTPOptions,
+	AssetType("GitRepository"): {"depth": validateIntOption},
+}
+
+// validateTargetOptions validates the common options of a target
+// against the registry of the provided asset type.
+func validateTargetOptions(at AssetType, opts map[string]any) error {
+	registry, ok := targetOptionRegistry[at]
+	if !ok {
+		return nil
+	}
+	for name, value := range opts {
+		validate, ok := registry[name]
+		if !ok {
+			continue
+		}
+		if err := validate(value); err != nil {
+			return fmt.Errorf("%w: %v: %w", ErrInvalidTargetOption, name, err)
+		}
+	}
+	return nil
+}
+
+// validateIntOption validates that v is an integer.
+func validateIntOption(v any) error {
+	switch v.(type) {
+	case int, int32, int64:
+		return nil
+	default:
+		return fmt.Errorf("value %v is not an integer", v)
+	}
+}
+
+// validateBoolOption validates that v is a boolean.
+func validateBoolOption(v any) error {
+	if _, ok := v.(bool); !ok {
+		return fmt.Errorf("value %v is not a boolean", v)
+	}
+	return nil
+}
+
+// validateHeadersOption validates that v is a map of string headers.
+func validateHeadersOption(v any) error {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return fmt.Errorf("value %v is not a map of headers", v)
+	}
+	for name, value := range m {
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("header %v value %v is not a string", name, value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 Adevinta
+
+package report
+
+import "github.com/adevinta/lava/internal/config"
+
+// matchesExclusion reports whether f matches e. At least one of
+// [config.Exclusion.Target], [config.Exclusion.Resource] or
+// [config.Exclusion.Fingerprint] must be set and match; an
+// [config.Exclusion] with none of them set matches nothing.
+func matchesExclusion(f Finding, e config.Exclusion) bool {
+	var matched bool
+
+	if e.Target != "" {
+		if e.Target != f.Target {
+			return false
+		}
+		matched = true
+	}
+	if e.Resource != "" {
+		if e.Resource != f.Resource {
+			return false
+		}
+		matched = true
+	}
+	if e.Fingerprint != "" {
+		if e.Fingerprint != f.Fingerprint {
+			return false
+		}
+		matched = true
+	}
+	return matched
+}
+
+// exclusionEffect returns whether a finding matched by e must be
+// part of the rendered report (inReport) and whether it must count
+// towards the exit code (countsToExit). [config.Exclusion.Scope]
+// selects which of these two dimensions [config.Exclusion.Action]
+// actually overrides; the other dimension keeps the default,
+// unexcluded behavior of being reported and counted.
+func exclusionEffect(e config.Exclusion) (inReport, countsToExit bool) {
+	inReport, countsToExit = true, true
+
+	var actionInReport, actionCountsToExit bool
+	switch e.Action {
+	case config.ExclusionActionWarn:
+		actionInReport, actionCountsToExit = true, false
+	case config.ExclusionActionDryRun:
+		actionInReport, actionCountsToExit = true, true
+	default: // config.ExclusionActionDeny
+		actionInReport, actionCountsToExit = false, false
+	}
+
+	switch e.Scope {
+	case config.ExclusionScopeReport:
+		inReport = actionInReport
+	case config.ExclusionScopeExit:
+		countsToExit = actionCountsToExit
+	default: // config.ExclusionScopeAll
+		inReport, countsToExit = actionInReport, actionCountsToExit
+	}
+	return inReport, countsToExit
+}
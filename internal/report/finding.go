@@ -0,0 +1,37 @@
+// Copyright 2023 Adevinta
+
+// Package report filters and renders the findings produced by a
+// Lava run according to a [config.ReportConfig].
+package report
+
+import "github.com/adevinta/lava/internal/config"
+
+// Finding represents a single vulnerability detected by a checktype
+// on a target.
+type Finding struct {
+	// CheckType is the name of the checktype that reported the
+	// finding. It is used as the SARIF rule id.
+	CheckType string
+
+	// Target is the identifier of the affected target.
+	Target string
+
+	// Resource is the name of the affected resource.
+	Resource string
+
+	// Summary is a short description of the finding.
+	Summary string
+
+	// Severity is the severity of the finding.
+	Severity config.Severity
+
+	// Status is the lifecycle status of the underlying
+	// vulnerability. It defaults to [config.StatusAffected] when
+	// not set by the check.
+	Status config.Status
+
+	// Fingerprint defines the context in where the finding has
+	// been found. It is matched against [config.Exclusion.Fingerprint]
+	// and used as the SARIF partial fingerprint.
+	Fingerprint string
+}
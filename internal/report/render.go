@@ -0,0 +1,19 @@
+// Copyright 2023 Adevinta
+
+package report
+
+import (
+	"encoding/json"
+
+	"github.com/adevinta/lava/internal/config"
+)
+
+// Render renders res according to format.
+func Render(format config.OutputFormat, res Result) ([]byte, error) {
+	switch format {
+	case config.OutputFormatSARIF:
+		return RenderSARIF(res.Findings, res.DryRun)
+	default:
+		return json.MarshalIndent(res.Findings, "", "  ")
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 Adevinta
+
+package report
+
+import "github.com/adevinta/lava/internal/config"
+
+// Result is the outcome of applying a [config.ReportConfig] to a set
+// of findings.
+type Result struct {
+	// Findings are the findings that must be part of the rendered
+	// report.
+	Findings []Finding
+
+	// DryRun are the findings matched by an [config.Exclusion] with
+	// [config.ExclusionActionDryRun], recorded separately from
+	// Findings without affecting Findings or Failed.
+	DryRun []Finding
+
+	// Failed reports whether the run must be considered failed,
+	// e.g. for a CI job to exit with a non-zero status.
+	Failed bool
+}
+
+// Apply filters findings according to cfg and decides whether the
+// run must be considered failed.
+func Apply(findings []Finding, cfg config.ReportConfig) Result {
+	allowedStatus := statusAllowlist(cfg.Status)
+
+	var res Result
+	for _, f := range findings {
+		if f.Severity < cfg.Severity {
+			continue
+		}
+
+		status := f.Status
+		if status == config.StatusUnknown {
+			status = config.StatusAffected
+		}
+		if !allowedStatus[status] {
+			continue
+		}
+
+		inReport, countsToExit := true, true
+		for _, excl := range cfg.Exclusions {
+			if !matchesExclusion(f, excl) {
+				continue
+			}
+			if excl.Action == config.ExclusionActionDryRun {
+				res.DryRun = append(res.DryRun, f)
+				continue
+			}
+			inReport, countsToExit = exclusionEffect(excl)
+			break
+		}
+
+		if inReport {
+			res.Findings = append(res.Findings, f)
+		}
+		if countsToExit {
+			res.Failed = true
+		}
+	}
+	return res
+}
+
+// statusAllowlist builds the set of statuses that are allowed in the
+// report. It defaults to [config.StatusAffected] when statuses is
+// empty.
+func statusAllowlist(statuses []config.Status) map[config.Status]bool {
+	if len(statuses) == 0 {
+		return map[config.Status]bool{config.StatusAffected: true}
+	}
+
+	allowed := make(map[config.Status]bool, len(statuses))
+	for _, s := range statuses {
+		allowed[s] = true
+	}
+	return allowed
+}
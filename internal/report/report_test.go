@@ -0,0 +1,121 @@
+// Copyright 2023 Adevinta
+
+package report
+
+import (
+	"testing"
+
+	"github.com/adevinta/lava/internal/config"
+)
+
+func TestApply(t *testing.T) {
+	findings := []Finding{
+		{CheckType: "vulcan-nessus", Target: "example.com", Severity: config.SeverityHigh},
+		{CheckType: "vulcan-nessus", Target: "example.com", Severity: config.SeverityLow},
+	}
+
+	res := Apply(findings, config.ReportConfig{Severity: config.SeverityMedium})
+
+	if len(res.Findings) != 1 {
+		t.Fatalf("unexpected number of findings: got: %v, want: 1", len(res.Findings))
+	}
+	if !res.Failed {
+		t.Error("unexpected Failed: got: false, want: true")
+	}
+}
+
+func TestApply_status(t *testing.T) {
+	findings := []Finding{
+		{CheckType: "vulcan-nessus", Target: "example.com", Status: config.StatusAffected},
+		{CheckType: "vulcan-nessus", Target: "example.com", Status: config.StatusWillNotFix},
+		{CheckType: "vulcan-nessus", Target: "example.com"},
+	}
+
+	res := Apply(findings, config.ReportConfig{
+		Status: []config.Status{config.StatusAffected},
+	})
+
+	if len(res.Findings) != 2 {
+		t.Fatalf("unexpected number of findings: got: %v, want: 2", len(res.Findings))
+	}
+}
+
+func TestApply_exclusions(t *testing.T) {
+	tests := []struct {
+		name         string
+		excl         config.Exclusion
+		wantFindings int
+		wantFailed   bool
+	}{
+		{
+			name:         "deny all",
+			excl:         config.Exclusion{Target: "example.com", Action: config.ExclusionActionDeny, Scope: config.ExclusionScopeAll},
+			wantFindings: 0,
+			wantFailed:   false,
+		},
+		{
+			name:         "deny scoped to exit keeps it visible",
+			excl:         config.Exclusion{Target: "example.com", Action: config.ExclusionActionDeny, Scope: config.ExclusionScopeExit},
+			wantFindings: 1,
+			wantFailed:   false,
+		},
+		{
+			name:         "deny scoped to report keeps it failing",
+			excl:         config.Exclusion{Target: "example.com", Action: config.ExclusionActionDeny, Scope: config.ExclusionScopeReport},
+			wantFindings: 0,
+			wantFailed:   true,
+		},
+		{
+			name:         "warn",
+			excl:         config.Exclusion{Target: "example.com", Action: config.ExclusionActionWarn, Scope: config.ExclusionScopeAll},
+			wantFindings: 1,
+			wantFailed:   false,
+		},
+		{
+			name:         "dryrun has no effect",
+			excl:         config.Exclusion{Target: "example.com", Action: config.ExclusionActionDryRun},
+			wantFindings: 1,
+			wantFailed:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := []Finding{{CheckType: "vulcan-nessus", Target: "example.com", Severity: config.SeverityHigh}}
+			res := Apply(findings, config.ReportConfig{Exclusions: []config.Exclusion{tt.excl}})
+
+			if len(res.Findings) != tt.wantFindings {
+				t.Errorf("unexpected number of findings: got: %v, want: %v", len(res.Findings), tt.wantFindings)
+			}
+			if res.Failed != tt.wantFailed {
+				t.Errorf("unexpected Failed: got: %v, want: %v", res.Failed, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestApply_dryRunRecorded(t *testing.T) {
+	findings := []Finding{{CheckType: "vulcan-nessus", Target: "example.com", Severity: config.SeverityHigh}}
+	res := Apply(findings, config.ReportConfig{
+		Exclusions: []config.Exclusion{{Target: "example.com", Action: config.ExclusionActionDryRun}},
+	})
+
+	if len(res.DryRun) != 1 {
+		t.Fatalf("unexpected number of dry-run matches: got: %v, want: 1", len(res.DryRun))
+	}
+}
+
+func TestApply_belowThreshold(t *testing.T) {
+	findings := []Finding{
+		{CheckType: "vulcan-nessus", Target: "example.com", Severity: config.SeverityLow},
+	}
+
+	res := Apply(findings, config.ReportConfig{Severity: config.SeverityMedium})
+
+	if len(res.Findings) != 0 {
+		t.Fatalf("unexpected number of findings: got: %v, want: 0", len(res.Findings))
+	}
+	if res.Failed {
+		t.Error("unexpected Failed: got: true, want: false")
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2023 Adevinta
+
+package report
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/adevinta/lava/internal/config"
+)
+
+// sarifVersion and sarifSchema identify the SARIF version rendered
+// by [RenderSARIF].
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+)
+
+// sarifFingerprintKey is the key used for the partial fingerprint
+// derived from [config.Exclusion.Fingerprint].
+const sarifFingerprintKey = "lavaFingerprint/v1"
+
+// sarifLog is the root object of a SARIF log, trimmed down to the
+// fields populated by Lava.
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID   string       `json:"id"`
+	Help sarifMessage `json:"help"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID              string             `json:"ruleId"`
+	Level               string             `json:"level"`
+	Message             sarifMessage       `json:"message"`
+	Locations           []sarifLocation    `json:"locations"`
+	PartialFingerprints map[string]string  `json:"partialFingerprints,omitempty"`
+	Suppressions        []sarifSuppression `json:"suppressions,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// sarifSuppression represents an accepted-risk finding matched by a
+// [config.Exclusion] in "dryrun" mode.
+type sarifSuppression struct {
+	Kind string `json:"kind"`
+}
+
+// RenderSARIF renders findings as a SARIF 2.1.0 log. suppressed
+// findings are included as results with a "suppressions" entry
+// instead of being dropped, so downstream tools can still display
+// accepted-risk items.
+func RenderSARIF(findings, suppressed []Finding) ([]byte, error) {
+	rules := make(map[string]sarifRule)
+	var results []sarifResult
+
+	addResult := func(f Finding, isSuppressed bool) {
+		if _, ok := rules[f.CheckType]; !ok {
+			rules[f.CheckType] = sarifRule{
+				ID:   f.CheckType,
+				Help: sarifMessage{Text: f.Summary},
+			}
+		}
+
+		result := sarifResult{
+			RuleID:  f.CheckType,
+			Level:   sarifLevel(f.Severity),
+			Message: sarifMessage{Text: f.Summary},
+			Locations: []sarifLocation{
+				{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{
+							URI: f.Target + "/" + f.Resource,
+						},
+					},
+				},
+			},
+		}
+		if f.Fingerprint != "" {
+			result.PartialFingerprints = map[string]string{sarifFingerprintKey: f.Fingerprint}
+		}
+		if isSuppressed {
+			result.Suppressions = []sarifSuppression{{Kind: "external"}}
+		}
+		results = append(results, result)
+	}
+
+	for _, f := range findings {
+		addResult(f, false)
+	}
+	for _, f := range suppressed {
+		addResult(f, true)
+	}
+
+	ids := make([]string, 0, len(rules))
+	for id := range rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	driver := sarifDriver{Name: "lava"}
+	for _, id := range ids {
+		driver.Rules = append(driver.Rules, rules[id])
+	}
+
+	log := sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []sarifRun{
+			{
+				Tool:    sarifTool{Driver: driver},
+				Results: results,
+			},
+		},
+	}
+	return json.MarshalIndent(log, "", "  ")
+}
+
+// sarifLevel maps a [config.Severity] to a SARIF result level.
+func sarifLevel(sev config.Severity) string {
+	switch {
+	case sev >= config.SeverityHigh:
+		return "error"
+	case sev == config.SeverityMedium:
+		return "warning"
+	default:
+		return "note"
+	}
+}